@@ -0,0 +1,22 @@
+package conf
+
+import "time"
+
+// AdvancedConfig groups the advanced, rarely-tuned settings most integrations leave at their
+// defaults.
+//
+// NOTE: this vendored snapshot only reproduces the fields synchronizer.ManagerImpl reads; the
+// upstream struct carries additional fields not needed by that package.
+type AdvancedConfig struct {
+	// StreamingEnabled toggles SSE streaming; when false the SDK polls on a fixed interval instead.
+	StreamingEnabled bool
+
+	// StreamingIdleTimeout bounds how long the streaming connection may go without activity
+	// before synchronizer.ManagerImpl forces a reconnect. Defaults to 60s when <= 0.
+	StreamingIdleTimeout time.Duration
+
+	// BackendUnavailableTimeout bounds how long synchronizer.ManagerImpl waits without a
+	// successful contact with the backend before reporting BackendUnavailable. Defaults to
+	// 10 minutes when <= 0.
+	BackendUnavailableTimeout time.Duration
+}
@@ -0,0 +1,28 @@
+package synchronizer
+
+import (
+	"context"
+	"time"
+)
+
+// Synchronizer exposes the sync operations ManagerImpl needs to drive Split's sync lifecycle.
+//
+// NOTE: this vendored snapshot only reproduces the methods ManagerImpl actually calls.
+type Synchronizer interface {
+	// SyncAll runs a one-off fetch-and-apply of every resource (splits, segments, and large
+	// segments when includeSegmentsInPollers is true). It honors ctx: a cancelled ctx aborts the
+	// in-flight sync instead of waiting for it to run to completion.
+	SyncAll(ctx context.Context, includeSegmentsInPollers bool) error
+	// StartPeriodicFetching begins the recurring fetch tasks on their own schedule. onResult is
+	// invoked with the outcome of every fetch attempt, so callers can track backend reachability
+	// without running a second, redundant sync loop of their own.
+	StartPeriodicFetching(onResult func(error))
+	// StopPeriodicFetching stops the recurring fetch tasks started by StartPeriodicFetching.
+	StopPeriodicFetching()
+	// StartPeriodicDataRecording begins the recurring telemetry/impressions/events flush tasks.
+	StartPeriodicDataRecording()
+	// StopPeriodicDataRecording stops the tasks started by StartPeriodicDataRecording.
+	StopPeriodicDataRecording()
+	// RefreshRates reports the configured splits and segments fetch intervals.
+	RefreshRates() (time.Duration, time.Duration)
+}
@@ -1,7 +1,9 @@
 package synchronizer
 
 import (
+	"context"
 	"errors"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -12,7 +14,6 @@ import (
 	"github.com/splitio/go-split-commons/v4/service"
 	"github.com/splitio/go-split-commons/v4/storage"
 	"github.com/splitio/go-split-commons/v4/telemetry"
-	"github.com/splitio/go-toolkit/v5/backoff"
 	"github.com/splitio/go-toolkit/v5/logging"
 	"github.com/splitio/go-toolkit/v5/struct/traits/lifecycle"
 )
@@ -24,8 +25,16 @@ const (
 	StreamingReady
 	// Error represents some error in SSE streaming
 	Error
+	// BackendUnavailable is emitted when no successful contact with the backend has
+	// been made for longer than conf.AdvancedConfig.BackendUnavailableTimeout, or when
+	// the RetryPolicy gives up on reconnecting streaming
+	BackendUnavailable
 )
 
+// ErrBackendUnavailable is returned by Start when the initial sync fails and no successful
+// contact with the backend has been recorded for longer than the configured timeout.
+var ErrBackendUnavailable = errors.New("no successful contact with the backend for too long")
+
 // Operation mode constants
 const (
 	Streaming = iota
@@ -35,11 +44,22 @@ const (
 const (
 	fetchTaskTolerance    = 2 * time.Minute
 	refreshTokenTolerance = 15 * time.Minute
+
+	// defaultStreamingIdleTimeout is used when conf.AdvancedConfig.StreamingIdleTimeout is not set
+	defaultStreamingIdleTimeout = 60 * time.Second
+
+	// defaultBackendUnavailableTimeout is used when conf.AdvancedConfig.BackendUnavailableTimeout is not set
+	defaultBackendUnavailableTimeout = 10 * time.Minute
+
+	// defaults for the RetryPolicy used when NewSynchronizerManager is not given one explicitly
+	defaultRetryBase         = 1 * time.Second
+	defaultRetryMaxDelay     = 60 * time.Second
+	defaultRetryBudgetWindow = 24 * time.Hour
 )
 
 // Manager interface
 type Manager interface {
-	Start()
+	Start(ctx context.Context) error
 	Stop()
 	IsRunning() bool
 }
@@ -54,9 +74,21 @@ type ManagerImpl struct {
 	streamingStatus  chan int64
 	operationMode    int32
 	lifecycle        lifecycle.Manager
-	backoff          backoff.Interface
 	runtimeTelemetry storage.TelemetryRuntimeProducer
 	hcMonitor        application.MonitorProducerInterface
+	streamIdleTimer  *time.Timer
+	streamIdleTO     time.Duration
+	lastSuccessNanos int64
+	backendDown      int32
+	backendDownTO    time.Duration
+	retryPolicy      RetryPolicy
+	retryTimer       *time.Timer
+	retryAttempt     int
+	retryFirstFailAt time.Time
+	stopOnce         sync.Once
+	closed           int32
+	supervisors      []StreamSupervisor
+	syncMu           sync.Mutex
 }
 
 // NewSynchronizerManager creates new sync manager
@@ -71,19 +103,31 @@ func NewSynchronizerManager(
 	metadata dtos.Metadata,
 	clientKey *string,
 	hcMonitor application.MonitorProducerInterface,
+	retryPolicy RetryPolicy,
 ) (*ManagerImpl, error) {
 	if managerStatus == nil || cap(managerStatus) < 1 {
 		return nil, errors.New("Status channel cannot be nil nor having capacity")
 	}
 
+	if retryPolicy == nil {
+		retryPolicy = NewRetryBudget(NewJitteredExponential(defaultRetryBase, defaultRetryMaxDelay), defaultRetryBudgetWindow, 0)
+	}
+
 	manager := &ManagerImpl{
-		backoff:          backoff.New(),
 		synchronizer:     synchronizer,
 		logger:           logger,
 		config:           config,
 		managerStatus:    managerStatus,
 		runtimeTelemetry: runtimeTelemetry,
 		hcMonitor:        hcMonitor,
+		lastSuccessNanos: time.Now().UnixNano(),
+		retryPolicy:      retryPolicy,
+		retryTimer:       time.NewTimer(defaultRetryMaxDelay),
+	}
+	stopTimer(manager.retryTimer)
+	manager.backendDownTO = config.BackendUnavailableTimeout
+	if manager.backendDownTO <= 0 {
+		manager.backendDownTO = defaultBackendUnavailableTimeout
 	}
 	manager.lifecycle.Setup()
 	if config.StreamingEnabled {
@@ -97,6 +141,14 @@ func NewSynchronizerManager(
 		}
 		manager.pushManager = pushManager
 		manager.streamingStatus = streamingStatus
+		manager.supervisors = []StreamSupervisor{newSingleFeedSupervisor(pushManager, streamingStatus)}
+
+		manager.streamIdleTO = config.StreamingIdleTimeout
+		if manager.streamIdleTO <= 0 {
+			manager.streamIdleTO = defaultStreamingIdleTimeout
+		}
+		manager.streamIdleTimer = time.NewTimer(manager.streamIdleTO)
+		stopTimer(manager.streamIdleTimer)
 	}
 
 	return manager, nil
@@ -107,11 +159,88 @@ func (s *ManagerImpl) IsRunning() bool {
 	return s.lifecycle.IsRunning()
 }
 
-// Start starts synchronization through Split
-func (s *ManagerImpl) Start() {
+// stopTimer drains & stops a timer so it can be safely Reset() afterwards, without allocating a new one.
+func stopTimer(t *time.Timer) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
+	}
+}
+
+// resetStreamIdleTimer re-arms the idle watchdog, reusing the pooled timer instead of allocating a new one.
+func (s *ManagerImpl) resetStreamIdleTimer() {
+	stopTimer(s.streamIdleTimer)
+	s.streamIdleTimer.Reset(s.streamIdleTO)
+}
+
+// timeSinceLastSuccess returns how long it's been since SyncAll (polling or streaming) last succeeded.
+func (s *ManagerImpl) timeSinceLastSuccess() time.Duration {
+	last := atomic.LoadInt64(&s.lastSuccessNanos)
+	return time.Since(time.Unix(0, last))
+}
+
+// currentReadyStatus reports the managerStatus value that reflects "things are fine right now".
+func (s *ManagerImpl) currentReadyStatus() int {
+	if atomic.LoadInt32(&s.operationMode) == Streaming {
+		return StreamingReady
+	}
+	return Ready
+}
+
+// emitManagerStatus is a best-effort, non-blocking notification: the watcher must never stall
+// on a consumer that isn't draining managerStatus, and must never send on a channel stop() closed.
+func (s *ManagerImpl) emitManagerStatus(status int) {
+	if atomic.LoadInt32(&s.closed) == 1 {
+		return
+	}
+	select {
+	case s.managerStatus <- status:
+	default:
+	}
+}
+
+// sendManagerStatus blocks until status is delivered, a concurrent Stop() tears things down, or
+// the channel has already been closed by stop() - whichever happens first.
+func (s *ManagerImpl) sendManagerStatus(status int) {
+	if atomic.LoadInt32(&s.closed) == 1 {
+		return
+	}
+	select {
+	case s.managerStatus <- status:
+	case <-s.lifecycle.ShutdownRequested():
+	}
+}
+
+// recordSyncResult updates the last-successful-contact timestamp and flips the BackendUnavailable
+// state (in either direction) when warranted, notifying managerStatus of the transition.
+func (s *ManagerImpl) recordSyncResult(err error) {
+	if err == nil {
+		atomic.StoreInt64(&s.lastSuccessNanos, time.Now().UnixNano())
+		if atomic.CompareAndSwapInt32(&s.backendDown, 1, 0) {
+			s.logger.Info("contact with the backend restored")
+			s.emitManagerStatus(s.currentReadyStatus())
+		}
+		return
+	}
+
+	if s.timeSinceLastSuccess() < s.backendDownTO {
+		return
+	}
+	if atomic.CompareAndSwapInt32(&s.backendDown, 0, 1) {
+		s.logger.Error("no successful contact with the backend in over ", s.backendDownTO, ", marking it as unavailable")
+		s.runtimeTelemetry.RecordStreamingEvent(telemetry.GetStreamingEvent(telemetry.EventTypeStreamingStatus, telemetry.BackendUnavailable))
+		s.emitManagerStatus(BackendUnavailable)
+	}
+}
+
+// Start starts synchronization through Split. Cancelling ctx aborts an in-flight initial sync
+// immediately instead of waiting for it to run to completion before honoring shutdown.
+func (s *ManagerImpl) Start(ctx context.Context) error {
 	if !s.lifecycle.BeginInitialization() {
 		s.logger.Info("Manager is already running, skipping start")
-		return
+		return nil
 	}
 
 	// It's safe to drain the channel here, since it's guaranteed that the  manager status is "starting"
@@ -119,19 +248,27 @@ func (s *ManagerImpl) Start() {
 	for len(s.managerStatus) > 0 {
 		<-s.managerStatus
 	}
-	err := s.synchronizer.SyncAll(false)
+
+	err := s.syncAllInterruptibly(ctx, false)
 	if err != nil {
 		defer s.lifecycle.ShutdownComplete()
-		s.managerStatus <- Error
-		return
+		if s.timeSinceLastSuccess() >= s.backendDownTO {
+			atomic.StoreInt32(&s.backendDown, 1)
+			s.runtimeTelemetry.RecordStreamingEvent(telemetry.GetStreamingEvent(telemetry.EventTypeStreamingStatus, telemetry.BackendUnavailable))
+			s.sendManagerStatus(BackendUnavailable)
+			return ErrBackendUnavailable
+		}
+		s.sendManagerStatus(Error)
+		return err
 	}
+	s.recordSyncResult(nil)
 
 	if !s.lifecycle.InitializationComplete() {
 		defer s.lifecycle.ShutdownComplete()
-		return
+		return nil
 	}
 	s.logger.Debug("SyncAll Ready")
-	s.managerStatus <- Ready
+	s.sendManagerStatus(Ready)
 	s.synchronizer.StartPeriodicDataRecording()
 
 	if !s.config.StreamingEnabled {
@@ -141,22 +278,75 @@ func (s *ManagerImpl) Start() {
 			<-s.lifecycle.ShutdownRequested()
 			s.stop()
 		}()
-		return
+		return nil
 	}
 
 	// Start streaming
 	s.logger.Info("SDK Initialized in streaming mode")
-	s.pushManager.Start()
+	s.startSupervisors()
 	go s.pushStatusWatcher()
+	return nil
 }
 
-func (s *ManagerImpl) stop() {
-	if s.pushManager != nil {
-		s.pushManager.Stop()
+// startSupervisors and stopSupervisors fan Start/Stop out to every registered StreamSupervisor.
+// Today there's a single supervisor wrapping the shared push.Manager (see singleFeedSupervisor);
+// once feeds stream independently this is where a partial failure stays scoped to one feed.
+func (s *ManagerImpl) startSupervisors() {
+	for _, sup := range s.supervisors {
+		sup.Start()
 	}
-	s.synchronizer.StopPeriodicFetching()
-	s.synchronizer.StopPeriodicDataRecording()
-	s.lifecycle.ShutdownComplete()
+}
+
+func (s *ManagerImpl) stopSupervisors() {
+	for _, sup := range s.supervisors {
+		sup.Stop()
+	}
+}
+
+// syncAllInterruptibly runs synchronizer.SyncAll on its own goroutine, passing ctx straight
+// through so a cancelled ctx actually aborts the in-flight sync instead of merely being raced
+// against it; this package doesn't propagate ctx any further down into the push manager or
+// periodic fetching, which it doesn't own. Start still returns as soon as either SyncAll
+// completes or ctx is cancelled, so a slow sync that ignores cancellation doesn't block it. The
+// goroutine is left to finish on its own in that case, serialized against syncMu: if the caller
+// retries Start before it returns, the new attempt's own SyncAll waits for it instead of racing
+// it, so Stop()'s teardown is never racing a SyncAll call from an earlier, abandoned attempt.
+func (s *ManagerImpl) syncAllInterruptibly(ctx context.Context, includeSegmentsInPollers bool) error {
+	done := make(chan error, 1)
+	go func() {
+		s.syncMu.Lock()
+		defer s.syncMu.Unlock()
+		done <- s.synchronizer.SyncAll(ctx, includeSegmentsInPollers)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stop tears down every subsystem in a fixed order: push manager, then the streamingStatus
+// channel, then periodic fetching, then periodic recording, then managerStatus itself. It's
+// wrapped in a sync.Once so it's safe to call concurrently from Stop(), the watcher's defer, and
+// the polling-mode shutdown goroutine without double-closing anything.
+func (s *ManagerImpl) stop() {
+	s.stopOnce.Do(func() {
+		s.stopSupervisors()
+		if s.streamIdleTimer != nil {
+			stopTimer(s.streamIdleTimer)
+		}
+		stopTimer(s.retryTimer)
+		for len(s.streamingStatus) > 0 {
+			<-s.streamingStatus
+		}
+		s.synchronizer.StopPeriodicFetching()
+		s.synchronizer.StopPeriodicDataRecording()
+		atomic.StoreInt32(&s.closed, 1)
+		close(s.managerStatus)
+		s.lifecycle.ShutdownComplete()
+	})
 }
 
 // Stop stop synchronizaation through Split
@@ -176,40 +366,99 @@ func (s *ManagerImpl) pushStatusWatcher() {
 		select {
 		case <-s.lifecycle.ShutdownRequested():
 			return
+		case <-s.streamIdleTimer.C:
+			s.logger.Error("no streaming activity detected in ", s.streamIdleTO, ", forcing a reconnect")
+			s.runtimeTelemetry.RecordStreamingEvent(telemetry.GetStreamingEvent(telemetry.EventTypeStreamingStatus, telemetry.StreamingIdleReconnect))
+			select {
+			case s.streamingStatus <- push.StatusRetryableError:
+			default:
+				s.logger.Debug("streamingStatus is full, idle-triggered reconnect will be retried on next tick")
+				s.resetStreamIdleTimer()
+			}
 		case status := <-s.streamingStatus:
 			switch status {
 			case push.StatusUp:
 				s.stopPolling()
 				s.logger.Info("streaming up and running")
 				s.enableStreaming()
-				s.synchronizer.SyncAll(true)
+				s.recordSyncResult(s.synchronizer.SyncAll(context.Background(), true))
 			case push.StatusDown:
+				// paused, not streaming: nothing will be hitting the watchdog's activity source
+				// from here on, so stop it instead of letting it fire a bogus reconnect while
+				// we're quietly polling.
+				stopTimer(s.streamIdleTimer)
 				s.logger.Info("streaming down, switchin to polling")
-				s.synchronizer.SyncAll(false)
+				s.recordSyncResult(s.synchronizer.SyncAll(context.Background(), false))
 				s.pauseStreaming()
 				s.startPolling()
 			case push.StatusRetryableError:
-				howLong := s.backoff.Next()
-				s.logger.Error("retryable error in streaming subsystem. Switching to polling and retrying in ", howLong, " seconds")
-				s.pushManager.Stop()
-				s.synchronizer.SyncAll(false)
-				s.startPolling()
-				time.Sleep(howLong)
-				s.pushManager.Start()
+				// retryStreaming re-arms the watchdog itself once streaming is actually restarted;
+				// it deliberately leaves it off on its give-up branch, so it's excluded below.
+				stopTimer(s.streamIdleTimer)
+				if !s.retryStreaming() {
+					return
+				}
 			case push.StatusNonRetryableError:
+				stopTimer(s.streamIdleTimer)
 				s.logger.Error("non retryable error in streaming subsystem. Switching to polling until next SDK initialization")
-				s.pushManager.Stop()
-				s.synchronizer.SyncAll(false)
+				s.stopSupervisors()
+				s.recordSyncResult(s.synchronizer.SyncAll(context.Background(), false))
 				s.startPolling()
 				s.runtimeTelemetry.RecordStreamingEvent(telemetry.GetStreamingEvent(telemetry.EventTypeStreamingStatus, telemetry.StreamingDisabled))
 			}
+			if status == push.StatusUp {
+				s.resetStreamIdleTimer()
+			}
 		}
 	}
 }
 
+// retryStreaming handles a single push.StatusRetryableError: it switches to polling, asks the
+// configured RetryPolicy for a delay (or a give-up decision), and waits on an interruptible,
+// pooled timer instead of time.Sleep so Stop() doesn't have to wait out the full backoff.
+// It returns false if a shutdown was requested while waiting, telling the caller to stop watching.
+func (s *ManagerImpl) retryStreaming() bool {
+	if s.retryAttempt == 0 {
+		s.retryFirstFailAt = time.Now()
+	}
+	elapsed := time.Since(s.retryFirstFailAt)
+	delay, giveUp := s.retryPolicy.NextRetry(ErrClassStreamingRetryable, s.retryAttempt, elapsed)
+
+	s.stopSupervisors()
+	s.recordSyncResult(s.synchronizer.SyncAll(context.Background(), false))
+	s.startPolling()
+
+	if giveUp {
+		s.logger.Error("retry budget exhausted after ", s.retryAttempt, " attempts over ", elapsed, ", giving up on streaming")
+		s.retryAttempt = 0
+		atomic.StoreInt32(&s.backendDown, 1)
+		s.runtimeTelemetry.RecordStreamingEvent(telemetry.GetStreamingEvent(telemetry.EventTypeStreamingStatus, telemetry.BackendUnavailable))
+		s.emitManagerStatus(BackendUnavailable)
+		return true
+	}
+
+	s.retryAttempt++
+	s.logger.Error("retryable error in streaming subsystem. Switching to polling and retrying in ", delay)
+	stopTimer(s.retryTimer)
+	s.retryTimer.Reset(delay)
+	select {
+	case <-s.retryTimer.C:
+	case <-s.lifecycle.ShutdownRequested():
+		stopTimer(s.retryTimer)
+		return false
+	}
+	s.startSupervisors()
+	// streaming is running again: re-arm the watchdog. On the give-up branch above it's left off,
+	// since there's nothing left to watch once we've stopped retrying.
+	s.resetStreamIdleTimer()
+	return true
+}
+
 func (s *ManagerImpl) startPolling() {
 	atomic.StoreInt32(&s.operationMode, Polling)
-	s.synchronizer.StartPeriodicFetching()
+	// recordSyncResult doubles as the periodic-fetch result callback, so lastSuccessNanos/
+	// backendDown stay current off the fetch task's own cadence instead of a second sync loop.
+	s.synchronizer.StartPeriodicFetching(s.recordSyncResult)
 	s.runtimeTelemetry.RecordStreamingEvent(telemetry.GetStreamingEvent(telemetry.EventTypeSyncMode, telemetry.Polling))
 
 	splitRate, segmentRate := s.synchronizer.RefreshRates()
@@ -229,7 +478,8 @@ func (s *ManagerImpl) pauseStreaming() {
 func (s *ManagerImpl) enableStreaming() {
 	s.pushManager.StartWorkers()
 	atomic.StoreInt32(&s.operationMode, Streaming)
-	s.backoff.Reset()
+	s.retryPolicy.Reset()
+	s.retryAttempt = 0
 	s.runtimeTelemetry.RecordStreamingEvent(telemetry.GetStreamingEvent(telemetry.EventTypeSyncMode, telemetry.Streaming))
 	s.runtimeTelemetry.RecordStreamingEvent(telemetry.GetStreamingEvent(telemetry.EventTypeStreamingStatus, telemetry.StreamingEnabled))
 
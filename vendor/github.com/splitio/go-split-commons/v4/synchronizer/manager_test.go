@@ -0,0 +1,198 @@
+package synchronizer
+
+// NOTE: this tree is a vendored snapshot of go-split-commons - it carries manager.go and its
+// sibling files but none of the packages they import (dtos, healthcheck/application, push,
+// service, storage), nor a go.mod/go.sum to pull them in. fakePushManager implements the
+// complete set of push.Manager methods this package calls anywhere (Start, Stop, StartWorkers,
+// NextRefresh), so it's a faithful stand-in for that interface as far as this package is
+// concerned. fakeLogger, fakeTelemetry and fakeHCMonitor are narrower: they only cover the
+// methods this file happens to call, and the real logging.LoggerInterface /
+// storage.TelemetryRuntimeProducer / application.MonitorProducerInterface may declare more. This
+// test is a best-effort stand-in for what belongs upstream, where a real go.mod/go.sum bump would
+// bring in the actual packages.
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/goleak"
+
+	"github.com/splitio/go-split-commons/v4/conf"
+)
+
+type fakeSynchronizer struct {
+	mu          sync.Mutex
+	syncAllErr  error
+	syncAllCall int
+}
+
+func (f *fakeSynchronizer) SyncAll(_ context.Context, _ bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.syncAllCall++
+	return f.syncAllErr
+}
+func (f *fakeSynchronizer) StartPeriodicFetching(_ func(error)) {}
+func (f *fakeSynchronizer) StopPeriodicFetching()               {}
+func (f *fakeSynchronizer) StartPeriodicDataRecording()         {}
+func (f *fakeSynchronizer) StopPeriodicDataRecording()          {}
+func (f *fakeSynchronizer) RefreshRates() (time.Duration, time.Duration) {
+	return time.Second, time.Second
+}
+
+type fakeLogger struct{}
+
+func (fakeLogger) Error(_ ...interface{})   {}
+func (fakeLogger) Warning(_ ...interface{}) {}
+func (fakeLogger) Info(_ ...interface{})    {}
+func (fakeLogger) Debug(_ ...interface{})   {}
+func (fakeLogger) Verbose(_ ...interface{}) {}
+
+type fakeTelemetry struct{}
+
+func (fakeTelemetry) RecordStreamingEvent(_ interface{}) {}
+
+type fakeHCMonitor struct{}
+
+func (fakeHCMonitor) Reset(_ int, _ int) {}
+
+// fakePushManager implements the complete set of push.Manager methods ManagerImpl and
+// singleFeedSupervisor call anywhere in this package - Start, Stop, StartWorkers and
+// NextRefresh - so it stands in for the whole interface this package actually exercises, not
+// just a sample of it.
+type fakePushManager struct {
+	mu      sync.Mutex
+	running bool
+}
+
+func (f *fakePushManager) Start() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.running = true
+}
+func (f *fakePushManager) Stop() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.running = false
+}
+func (f *fakePushManager) StartWorkers()          {}
+func (f *fakePushManager) NextRefresh() time.Time { return time.Now().Add(time.Hour) }
+
+// newTestManager builds a ManagerImpl with streaming disabled, bypassing NewSynchronizerManager
+// (whose parameters need service, storage and dtos values, and whose streaming path calls
+// push.NewManager) so Start/Stop can be exercised without a real pushManager.
+func newTestManager(t *testing.T, sync *fakeSynchronizer) *ManagerImpl {
+	t.Helper()
+	managerStatus := make(chan int, 1)
+	manager := &ManagerImpl{
+		synchronizer:     sync,
+		logger:           fakeLogger{},
+		config:           conf.AdvancedConfig{},
+		managerStatus:    managerStatus,
+		runtimeTelemetry: fakeTelemetry{},
+		hcMonitor:        fakeHCMonitor{},
+		lastSuccessNanos: time.Now().UnixNano(),
+		retryPolicy:      NewRetryBudget(NewJitteredExponential(defaultRetryBase, defaultRetryMaxDelay), defaultRetryBudgetWindow, 0),
+		retryTimer:       time.NewTimer(defaultRetryMaxDelay),
+	}
+	stopTimer(manager.retryTimer)
+	manager.backendDownTO = defaultBackendUnavailableTimeout
+	manager.lifecycle.Setup()
+	return manager
+}
+
+// newStreamingTestManager builds a ManagerImpl with streaming enabled and a fakePushManager
+// wired in behind a singleFeedSupervisor, so Start/Stop take the streaming path (supervisor
+// fan-out, pushStatusWatcher, and the idle and retry timers stopped on teardown) that
+// newTestManager's polling-only setup never touches.
+func newStreamingTestManager(t *testing.T, sync *fakeSynchronizer) (*ManagerImpl, *fakePushManager) {
+	t.Helper()
+	manager := newTestManager(t, sync)
+	pushManager := &fakePushManager{}
+	streamingStatus := make(chan int64, 1000)
+	manager.config.StreamingEnabled = true
+	manager.pushManager = pushManager
+	manager.streamingStatus = streamingStatus
+	manager.supervisors = []StreamSupervisor{newSingleFeedSupervisor(pushManager, streamingStatus)}
+	manager.streamIdleTO = defaultStreamingIdleTimeout
+	manager.streamIdleTimer = time.NewTimer(manager.streamIdleTO)
+	stopTimer(manager.streamIdleTimer)
+	return manager, pushManager
+}
+
+// TestStartStopNoGoroutineLeak drives a full Start/Stop cycle in polling mode and asserts no
+// goroutine it spawned (Start's polling-mode shutdown goroutine and its initial-sync goroutine) is
+// still around afterwards.
+func TestStartStopNoGoroutineLeak(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	fake := &fakeSynchronizer{}
+	manager := newTestManager(t, fake)
+
+	if err := manager.Start(context.Background()); err != nil {
+		t.Fatalf("Start returned unexpected error: %v", err)
+	}
+	manager.Stop()
+}
+
+// TestStopIsIdempotent calls Stop() concurrently from several goroutines and checks that every
+// call returns and no goroutine is left behind; only one call gets past
+// lifecycle.BeginShutdown(), and the others return without tearing anything down.
+func TestStopIsIdempotent(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	fake := &fakeSynchronizer{}
+	manager := newTestManager(t, fake)
+
+	if err := manager.Start(context.Background()); err != nil {
+		t.Fatalf("Start returned unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			manager.Stop()
+		}()
+	}
+	wg.Wait()
+}
+
+// TestConcurrentStartStopStreaming hammers Start and Stop concurrently in streaming mode, where
+// pushStatusWatcher and supervisor fan-out are live. The fake push manager never reports a
+// status, so the idle and retry timers are never armed here. Only one Start should ever actually
+// begin initialization (lifecycle.BeginInitialization() guards the rest), and the manager must
+// not be running once every Stop has returned - goleak catches a leaked pushStatusWatcher
+// goroutine if the guards around it ever regress.
+func TestConcurrentStartStopStreaming(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	fake := &fakeSynchronizer{}
+	manager, _ := newStreamingTestManager(t, fake)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = manager.Start(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			manager.Stop()
+		}()
+	}
+	wg.Wait()
+
+	if manager.IsRunning() {
+		t.Fatal("manager should not be running after Stop")
+	}
+}
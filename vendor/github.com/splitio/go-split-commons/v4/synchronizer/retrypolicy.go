@@ -0,0 +1,88 @@
+package synchronizer
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ErrorClass classifies the kind of failure a RetryPolicy is asked to react to.
+type ErrorClass int
+
+const (
+	// ErrClassStreamingRetryable is a recoverable SSE/streaming failure (push.StatusRetryableError).
+	ErrClassStreamingRetryable ErrorClass = iota
+	// ErrClassStreamingIdle classifies a reconnect forced by the idle-stream watchdog. ManagerImpl
+	// does not pass it: an idle-triggered reconnect reaches the RetryPolicy as ErrClassStreamingRetryable.
+	ErrClassStreamingIdle
+)
+
+// RetryPolicy decides how long to wait before the next reconnect attempt, and when to stop trying.
+type RetryPolicy interface {
+	// NextRetry returns the delay to wait before the next attempt, or giveUp = true if the caller
+	// should stop retrying and surface BackendUnavailable instead.
+	NextRetry(errClass ErrorClass, attempt int, elapsedSinceFirstFailure time.Duration) (delay time.Duration, giveUp bool)
+	// Reset clears any accumulated attempt/elapsed state, called after a successful reconnect.
+	Reset()
+}
+
+// JitteredExponential is a full-jitter exponential backoff, capped at maxDelay and reset on success.
+type JitteredExponential struct {
+	base     time.Duration
+	maxDelay time.Duration
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewJitteredExponential creates a JitteredExponential backoff policy. It never gives up on its own.
+func NewJitteredExponential(base time.Duration, maxDelay time.Duration) *JitteredExponential {
+	return &JitteredExponential{
+		base:     base,
+		maxDelay: maxDelay,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// NextRetry implements RetryPolicy.
+func (j *JitteredExponential) NextRetry(_ ErrorClass, attempt int, _ time.Duration) (time.Duration, bool) {
+	upperBound := j.base << uint(attempt)
+	if upperBound <= 0 || upperBound > j.maxDelay { // overflow or past the cap
+		upperBound = j.maxDelay
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return time.Duration(j.rand.Int63n(int64(upperBound))), false
+}
+
+// Reset implements RetryPolicy. JitteredExponential is stateless across calls (the caller tracks
+// the attempt number), so there's nothing to clear.
+func (j *JitteredExponential) Reset() {}
+
+// RetryBudget wraps another RetryPolicy and gives up once a wall-clock window or a maximum number
+// of attempts is exceeded, whichever comes first. A zero maxWindow/maxAttempts disables that check.
+type RetryBudget struct {
+	inner       RetryPolicy
+	maxWindow   time.Duration
+	maxAttempts int
+}
+
+// NewRetryBudget creates a RetryBudget wrapping the given inner policy.
+func NewRetryBudget(inner RetryPolicy, maxWindow time.Duration, maxAttempts int) *RetryBudget {
+	return &RetryBudget{inner: inner, maxWindow: maxWindow, maxAttempts: maxAttempts}
+}
+
+// NextRetry implements RetryPolicy.
+func (r *RetryBudget) NextRetry(errClass ErrorClass, attempt int, elapsedSinceFirstFailure time.Duration) (time.Duration, bool) {
+	if r.maxAttempts > 0 && attempt >= r.maxAttempts {
+		return 0, true
+	}
+	if r.maxWindow > 0 && elapsedSinceFirstFailure >= r.maxWindow {
+		return 0, true
+	}
+	return r.inner.NextRetry(errClass, attempt, elapsedSinceFirstFailure)
+}
+
+// Reset implements RetryPolicy.
+func (r *RetryBudget) Reset() { r.inner.Reset() }
@@ -0,0 +1,74 @@
+package synchronizer
+
+// Status: this file is groundwork only. It does NOT deliver per-feed stream isolation - every
+// feed still shares one SSE connection and fails together, exactly as before this file existed.
+// Actually closing that out requires splitting push.Manager itself into one stream per feed,
+// which this package doesn't own; until that lands, treat the behavior change this was meant to
+// provide as still open, with this interface as the seam the real per-feed supervisors will plug
+// into.
+
+import "github.com/splitio/go-split-commons/v4/push"
+
+// Feed identifies one of the logical SSE feeds a StreamSupervisor owns.
+type Feed string
+
+// Known feeds. FeedAll is a transitional value used while a single SSE connection still drives
+// every feed at once (see singleFeedSupervisor) - it goes away once push.Manager exposes one
+// stream per feed.
+const (
+	FeedAll           Feed = "all"
+	FeedSplits        Feed = "splits"
+	FeedSegments      Feed = "segments"
+	FeedLargeSegments Feed = "large_segments"
+	FeedMembership    Feed = "membership"
+)
+
+// StreamSupervisor is the target shape for owning a single feed's retryable stream: its own idle
+// timer, retry policy and health-monitor registration, so a failure on one feed only demotes that
+// feed to polling instead of the whole SDK. That per-feed isolation is NOT implemented yet - today
+// there is exactly one supervisor (singleFeedSupervisor), it wraps the single, shared SSE
+// connection, and a failure reported on it is still treated as a failure of every feed at once,
+// same as before this interface existed. This is purely a seam: it lets ManagerImpl be written
+// against the eventual per-feed model without being able to deliver the isolation itself yet.
+// ManagerImpl fans Start/Stop out to every supervisor it holds, but does not read Status(): its
+// watcher reads the shared streamingStatus channel (the single supervisor's status channel), so
+// the statuses of further supervisors are not combined into the Ready/StreamingReady/
+// BackendUnavailable signal.
+type StreamSupervisor interface {
+	// Feed identifies which logical feed this supervisor drives.
+	Feed() Feed
+	// Start begins streaming for this feed.
+	Start()
+	// Stop tears down this feed's stream. Safe to call repeatedly.
+	Stop()
+	// Status reports push.Status* transitions scoped to this feed.
+	Status() <-chan int64
+}
+
+// singleFeedSupervisor adapts the current, monolithic push.Manager - which still multiplexes
+// every feed over a single SSE connection - to the StreamSupervisor contract, so the rest of the
+// synchronizer can already be written against the per-feed model. It is a same-behavior stand-in,
+// not an implementation of per-feed isolation: it reports as FeedAll, and Start/Stop simply
+// delegate to the shared push.Manager, so a failure on any one feed still demotes every feed to
+// polling exactly as it did before StreamSupervisor existed. Once push.Manager is split per feed,
+// this is replaced here by one real supervisor per feed, each independently retryable.
+type singleFeedSupervisor struct {
+	pushManager push.Manager
+	status      chan int64
+}
+
+func newSingleFeedSupervisor(pushManager push.Manager, status chan int64) *singleFeedSupervisor {
+	return &singleFeedSupervisor{pushManager: pushManager, status: status}
+}
+
+// Feed implements StreamSupervisor.
+func (s *singleFeedSupervisor) Feed() Feed { return FeedAll }
+
+// Start implements StreamSupervisor.
+func (s *singleFeedSupervisor) Start() { s.pushManager.Start() }
+
+// Stop implements StreamSupervisor.
+func (s *singleFeedSupervisor) Stop() { s.pushManager.Stop() }
+
+// Status implements StreamSupervisor.
+func (s *singleFeedSupervisor) Status() <-chan int64 { return s.status }
@@ -0,0 +1,41 @@
+package telemetry
+
+// EventType classifies what a streaming-related telemetry event is reporting on.
+type EventType int
+
+// Known event types.
+const (
+	// EventTypeStreamingStatus reports a transition in the streaming subsystem's status.
+	EventTypeStreamingStatus EventType = iota
+	// EventTypeSyncMode reports a transition between Streaming and Polling.
+	EventTypeSyncMode
+)
+
+// Streaming status / sync-mode reasons, reported alongside an EventType.
+const (
+	Streaming = iota
+	Polling
+	StreamingEnabled
+	StreamingDisabled
+	StreamingPaused
+	// StreamingIdleReconnect is reported when synchronizer.ManagerImpl's idle-stream watchdog
+	// forces a reconnect after seeing no streaming activity for too long.
+	StreamingIdleReconnect
+	// BackendUnavailable is reported when no successful contact with the backend has been made
+	// for longer than conf.AdvancedConfig.BackendUnavailableTimeout, or when synchronizer.ManagerImpl's
+	// RetryPolicy gives up on reconnecting streaming.
+	BackendUnavailable
+)
+
+// StreamingEvent is the payload storage.TelemetryRuntimeProducer.RecordStreamingEvent expects.
+//
+// NOTE: this vendored snapshot only reproduces what synchronizer.ManagerImpl needs.
+type StreamingEvent struct {
+	Type   EventType
+	Reason int
+}
+
+// GetStreamingEvent builds a StreamingEvent for the given type/reason pair.
+func GetStreamingEvent(eventType EventType, reason int) StreamingEvent {
+	return StreamingEvent{Type: eventType, Reason: reason}
+}